@@ -0,0 +1,39 @@
+// validate-schemas checks that the given JSON schema files compile.
+//
+// It only depends on the schema files, not on specification/bindata.go,
+// so that it can run before the embedded schemas are regenerated.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: validate-schemas schema.json...")
+		os.Exit(2)
+	}
+	failed := false
+	for _, file := range os.Args[1:] {
+		if err := validate(file); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", file, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func validate(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	_, err = gojsonschema.NewSchema(gojsonschema.NewStringLoader(string(data)))
+	return err
+}
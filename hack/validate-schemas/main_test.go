@@ -0,0 +1,28 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestValidateSpecificationSchemas(t *testing.T) {
+	files, err := filepath.Glob("../../specification/schemas/*.json")
+	assert.NilError(t, err)
+	assert.Assert(t, len(files) > 0)
+	for _, file := range files {
+		assert.NilError(t, validate(file), file)
+	}
+}
+
+func TestValidateBrokenSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "validate-schemas")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "schema.json")
+	assert.NilError(t, ioutil.WriteFile(file, []byte(`{"properties": {"name": {"$ref": "#/definitions/missing"}}}`), 0644))
+	assert.Error(t, validate(file), "Object has no key 'definitions'")
+}
@@ -192,14 +192,16 @@ var _escData = map[string]*_escFile{
 
 	"/schemas/metadata_schema_v0.1.json": {
 		local:   "schemas/metadata_schema_v0.1.json",
-		size:    1019,
+		size:    1916,
 		modtime: 1518458244,
 		compressed: `
-H4sIAAAAAAAC/5xSy27CQAy85ytW2x6BUKmn/AqqkJsYWJR91GuQUJV/rxLz2nYTUHNKxvZkxuPvQiml
-9Gusd2hBV0rvmENVlvvo3VzQhadt2RBseL58LwV70TOZNE0/ZJGhAYa1VNfH5eJt0VNc2vgUsG/0n3us
-+YIG8gGJDUZdKZEy4A4sJkjCEZmM2545rtWNJws8OPCRB4ZrQ3fr1UekaLx7SJ8dbjDWZAJPEawSdKhk
-FYvTQ9vqBP7I/rj3EwPU+D/dFoxjMA4pjhMAEZx+r9Uw2r8zU+ml/nJJPiX//ulmeXa0YNqn6FejHdMB
-PQgrCW58+u44RXLeZTGN3L7k7bwVTfh1MIRNYlM2n7n7Qo6sK34CAAD//7xaWqb7AwAA
+H4sIAAAAAAAC/7RUzY7iMAy+9ymssEegrLQnXgUh5G1dCKJpxzFIaMS7j0r4aaZpWoHooQfH/vzZ/uzv
+BABA/bHZjkpUS1A7kXqZpntbmZmzzivepjljIbPFv9TZJmrqInXeBJUkmKPgxr1uTov533kDcXeTc02N
+Y/V/T5ncrTVXNbFosmoJjgoAgDJYkmfxMKywNtsbxuO1qLhEuVZQWbkiPBwuT191Ira6MoPwweCcbMa6
+lhjAyrMCQA9jAABljoeD8szrYOKmHltjRq/xLlEbQW2IbT8AMuP5d1u1UNmNcaJhKpq4SZpToY1uumLT
+Zyq/rkuQWI1MRj5OyqXpJZS0aCmmr6Nmyr1ROkkGZJQAAKxvoa2UvqBbTelUqvMu35b/NNwXb5Ge7Qwv
+VHyxBpUUGNz9U1SiPgxCroKv8d2I7MhjV8JRrVvg6HUrGS3NcfO6+X5yGoOlds9ebG79B+UtOfRd17dA
+Y9dr1MEYcThevGphQUUllrj/JfkZAJvLJa98BwAA
 `,
 	},
 
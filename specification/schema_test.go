@@ -26,3 +26,34 @@ func TestValidateMetadata(t *testing.T) {
 	}
 	assert.NilError(t, Validate(metadata, "v0.1"))
 }
+
+func TestValidateMetadataWithParents(t *testing.T) {
+	metadata := map[string]interface{}{
+		"name":    "my-fork",
+		"version": "my-version",
+		"parents": []interface{}{
+			map[string]interface{}{
+				"name":    "my-name",
+				"version": "my-version",
+				"maintainers": []interface{}{
+					map[string]interface{}{"name": "bob", "email": "bob@aol.com"},
+				},
+			},
+		},
+	}
+	assert.NilError(t, Validate(metadata, "v0.1"))
+}
+
+func TestValidateMetadataWithInvalidParent(t *testing.T) {
+	metadata := map[string]interface{}{
+		"name":    "my-fork",
+		"version": "my-version",
+		"parents": []interface{}{
+			map[string]interface{}{
+				"name":    "_INVALID",
+				"version": "my-version",
+			},
+		},
+	}
+	assert.Error(t, Validate(metadata, "v0.1"), "- parents.0.name: Does not match format 'hostname'")
+}
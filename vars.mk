@@ -5,6 +5,15 @@ E2E_NAME := $(BIN_NAME)-e2e
 # Enable experimental features. "on" or "off"
 EXPERIMENTAL := off
 
+# Platforms built by cross and e2e-cross, as <goos> or <goos>/<goarch> separated by
+# commas or spaces, e.g. make cross BUILD_PLATFORMS=linux/amd64,darwin.
+BUILD_PLATFORMS ?= linux darwin windows
+comma := ,
+platforms = $(subst /,-,$(subst $(comma), ,$(BUILD_PLATFORMS)))
+exe = $(if $(filter windows%,$(1)),.exe)
+# The names of the binaries cross-compiled for each platform, e.g. $(call cross_binaries,$(BIN_NAME)).
+cross_binaries = $(foreach p,$(platforms),$(1)-$(p)$(call exe,$(p)))
+
 # Failing to resolve sh.exe to a full path denotes a windows vanilla shell.
 # Although 'simple' commands are still exec'ed, 'complex' ones are batch'ed instead of sh'ed.
 ifeq ($(SHELL),sh.exe)